@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// AStar computes the shortest path between a source and a target vertex using
+// the A* search algorithm. Like ShortestPath, it returns a slice of hash
+// values of the vertices forming that path, including the source and target
+// vertices.
+//
+// h is a heuristic function that estimates the remaining cost from a given
+// vertex to target. For AStar to find an optimal path, h must be admissible,
+// i.e. it must never overestimate the true remaining cost. If h is nil, the
+// null heuristic is used and AStar degenerates into the same Dijkstra search
+// performed by ShortestPath.
+//
+// For unweighted graphs, AStar uses a unit edge weight the same way
+// ShortestPath does. If the target is not reachable from the source,
+// ErrTargetNotReachable will be returned.
+func AStar[K comparable, T any](g Graph[K, T], source, target K, h func(K) float64) ([]K, error) {
+	if h == nil {
+		h = func(K) float64 { return 0 }
+	}
+
+	gScore := make(map[K]float64)
+	gScore[source] = 0
+
+	queue := newPriorityQueue[K]()
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		priority := 0.0
+
+		if hash != source {
+			gScore[hash] = math.Inf(1)
+			priority = math.Inf(1)
+		} else {
+			priority = h(source)
+		}
+
+		queue.Push(hash, priority)
+	}
+
+	bestPredecessors := make(map[K]K)
+
+	// closed marks vertices the queue has already popped. A merely admissible
+	// (rather than consistent) heuristic can still yield a cheaper path to an
+	// already-closed vertex later on, so closed entries must be reopened
+	// instead of relying on queue.UpdatePriority, which is a no-op once an
+	// item has left the queue.
+	closed := make(map[K]bool)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+		closed[vertex] = true
+
+		if vertex == target {
+			break
+		}
+
+		hasInfiniteGScore := math.IsInf(gScore[vertex], 1)
+
+		for adjacency, edge := range adjacencyMap[vertex] {
+			edgeWeight := edge.Properties.Weight
+
+			// Setting the weight to 1 is required for unweighted graphs whose
+			// edge weights are 0, just like ShortestPath does.
+			if !g.Traits().IsWeighted {
+				edgeWeight = 1
+			}
+
+			if edgeWeight < 0 {
+				return nil, fmt.Errorf("AStar requires nonnegative edge weights, but got %v on edge (%v, %v)", edgeWeight, vertex, adjacency)
+			}
+
+			tentativeGScore := gScore[vertex] + float64(edgeWeight)
+
+			if tentativeGScore < gScore[adjacency] && !hasInfiniteGScore {
+				gScore[adjacency] = tentativeGScore
+				bestPredecessors[adjacency] = vertex
+				priority := tentativeGScore + h(adjacency)
+
+				if closed[adjacency] {
+					closed[adjacency] = false
+					queue.Push(adjacency, priority)
+				} else {
+					queue.UpdatePriority(adjacency, priority)
+				}
+			}
+		}
+	}
+
+	path := []K{target}
+	current := target
+
+	for current != source {
+		if _, ok := bestPredecessors[current]; !ok {
+			return nil, ErrTargetNotReachable
+		}
+		current = bestPredecessors[current]
+		path = append([]K{current}, path...)
+	}
+
+	return path, nil
+}
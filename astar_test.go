@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAStarNilHeuristicMatchesShortestPath(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 5; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][3]int{
+		{0, 1, 2},
+		{0, 2, 5},
+		{1, 3, 1},
+		{2, 3, 1},
+		{3, 4, 3},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1], EdgeWeight(e[2])); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	want, err := ShortestPath(g, 0, 4)
+	if err != nil {
+		t.Fatalf("ShortestPath returned an error: %v", err)
+	}
+
+	got, err := AStar(g, 0, 4, nil)
+	if err != nil {
+		t.Fatalf("AStar returned an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AStar with nil heuristic = %v, want %v (ShortestPath)", got, want)
+	}
+}
+
+func TestAStarTargetNotReachable(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	if _, err := AStar(g, 0, 1, nil); err != ErrTargetNotReachable {
+		t.Errorf("AStar error = %v, want %v", err, ErrTargetNotReachable)
+	}
+}
+
+// TestAStarInconsistentButAdmissibleHeuristic is a regression test for a case
+// where an admissible-but-inconsistent heuristic caused AStar to return a
+// suboptimal path: once a vertex was popped from the queue, a later cheaper
+// relaxation of that vertex was never reopened/re-propagated.
+func TestAStarInconsistentButAdmissibleHeuristic(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 5; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	adj := map[int][]int{
+		0: {2, 3},
+		1: {4},
+		2: {1, 4},
+		3: {0, 1, 2},
+		4: {0},
+	}
+	weights := map[int][]int{
+		0: {7, 6},
+		1: {5},
+		2: {2, 7},
+		3: {10, 1, 1},
+		4: {6},
+	}
+
+	for source, targets := range adj {
+		for i, target := range targets {
+			if err := g.AddEdge(source, target, EdgeWeight(weights[source][i])); err != nil {
+				t.Fatalf("failed to add edge %d->%d: %v", source, target, err)
+			}
+		}
+	}
+
+	h := map[int]float64{
+		0: 10.96,
+		1: -7.86,
+		2: -5.91,
+		3: -4.58,
+		4: 0,
+	}
+
+	got, err := AStar(g, 0, 4, func(k int) float64 { return h[k] })
+	if err != nil {
+		t.Fatalf("AStar returned an error: %v", err)
+	}
+
+	want := []int{0, 3, 1, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AStar with admissible-but-inconsistent heuristic = %v, want %v", got, want)
+	}
+}
+
+func TestAStarNegativeEdgeWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	if err := g.AddEdge(0, 1, EdgeWeight(-1)); err != nil {
+		t.Fatalf("failed to add edge 0->1: %v", err)
+	}
+
+	if _, err := AStar(g, 0, 1, nil); err == nil {
+		t.Error("AStar with a negative edge weight should return an error, got nil")
+	}
+}
@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNegativeCycle is returned by BellmanFordShortestPath and
+// BellmanFordShortestPathTree when the graph contains a cycle whose edges sum
+// to a negative weight. A negative cycle makes the notion of "shortest path"
+// ill-defined, since walking the cycle repeatedly decreases the total weight
+// without bound.
+var ErrNegativeCycle = errors.New("negative cycle detected")
+
+// BellmanFordShortestPath computes the shortest path between a source and a
+// target vertex under consideration of the edge weights, the same way
+// ShortestPath does. Unlike ShortestPath, which uses Dijkstra's algorithm,
+// BellmanFordShortestPath uses the Bellman-Ford algorithm and therefore also
+// supports graphs with negative edge weights.
+//
+// The returned path includes the source and target vertices. If the target is
+// not reachable from the source, ErrTargetNotReachable will be returned. If
+// the graph contains a cycle reachable from source whose total weight is
+// negative, ErrNegativeCycle will be returned instead.
+//
+// BellmanFordShortestPath has a time complexity of O(|V|*|E|).
+func BellmanFordShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+	_, predecessors, err := BellmanFordShortestPathTree(g, source)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []K{target}
+	current := target
+
+	for current != source {
+		predecessor, ok := predecessors[current]
+		if !ok {
+			return nil, ErrTargetNotReachable
+		}
+		current = predecessor
+		path = append([]K{current}, path...)
+	}
+
+	return path, nil
+}
+
+// BellmanFordShortestPathTree computes the shortest path from source to every
+// other vertex reachable from it, returning the accumulated distance and the
+// cheapest predecessor for each vertex. It is the Bellman-Ford counterpart to
+// the predecessor map ShortestPath builds up internally, exposed so callers
+// can reuse a single run across several targets.
+//
+// If the graph contains a cycle reachable from source whose total weight is
+// negative, ErrNegativeCycle will be returned, wrapping at least one vertex
+// that participates in the cycle.
+func BellmanFordShortestPathTree[K comparable, T any](g Graph[K, T], source K) (dist map[K]float64, pred map[K]K, err error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	dist = make(map[K]float64)
+	pred = make(map[K]K)
+
+	for hash := range adjacencyMap {
+		dist[hash] = math.Inf(1)
+	}
+	dist[source] = 0
+
+	edgeWeight := func(vertex, adjacency K, edge Edge[K]) float64 {
+		if !g.Traits().IsWeighted {
+			return 1
+		}
+		return float64(edge.Properties.Weight)
+	}
+
+	// Relax every edge |V|-1 times. After this loop, dist holds the shortest
+	// distance from source to every vertex, unless a negative cycle is
+	// reachable from source.
+	for i := 0; i < len(adjacencyMap)-1; i++ {
+		changed := false
+
+		for vertex, edges := range adjacencyMap {
+			if math.IsInf(dist[vertex], 1) {
+				continue
+			}
+
+			for adjacency, edge := range edges {
+				weight := dist[vertex] + edgeWeight(vertex, adjacency, edge)
+
+				if weight < dist[adjacency] {
+					dist[adjacency] = weight
+					pred[adjacency] = vertex
+					changed = true
+				}
+			}
+		}
+
+		// Relaxation has converged; no need to run the remaining iterations.
+		if !changed {
+			break
+		}
+	}
+
+	// One additional pass: if any edge can still be relaxed, it's part of or
+	// reachable from a negative cycle.
+	for vertex, edges := range adjacencyMap {
+		if math.IsInf(dist[vertex], 1) {
+			continue
+		}
+
+		for adjacency, edge := range edges {
+			weight := dist[vertex] + edgeWeight(vertex, adjacency, edge)
+
+			if weight < dist[adjacency] {
+				pred[adjacency] = vertex
+				cycle := negativeCycleFrom(pred, adjacency, len(adjacencyMap))
+				return nil, nil, fmt.Errorf("%w: %v", ErrNegativeCycle, cycle)
+			}
+		}
+	}
+
+	return dist, pred, nil
+}
+
+// negativeCycleFrom walks the predecessor chain back |V| steps starting at
+// vertex, which is guaranteed to land inside the negative cycle affecting it,
+// and returns the vertices of that cycle in traversal order.
+func negativeCycleFrom[K comparable](pred map[K]K, vertex K, order int) []K {
+	current := vertex
+	for i := 0; i < order; i++ {
+		current = pred[current]
+	}
+
+	cycle := []K{current}
+	for next := pred[current]; next != current; next = pred[next] {
+		cycle = append(cycle, next)
+	}
+
+	return cycle
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBellmanFordShortestPathNegativeWeights(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 4; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][3]int{
+		{0, 1, 4},
+		{0, 2, 5},
+		{1, 3, -3},
+		{2, 3, 1},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1], EdgeWeight(e[2])); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	got, err := BellmanFordShortestPath(g, 0, 3)
+	if err != nil {
+		t.Fatalf("BellmanFordShortestPath returned an error: %v", err)
+	}
+
+	want := []int{0, 1, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BellmanFordShortestPath = %v, want %v", got, want)
+	}
+}
+
+func TestBellmanFordShortestPathTargetNotReachable(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	if _, err := BellmanFordShortestPath(g, 0, 1); err != ErrTargetNotReachable {
+		t.Errorf("BellmanFordShortestPath error = %v, want %v", err, ErrTargetNotReachable)
+	}
+}
+
+func TestBellmanFordShortestPathTreeNegativeCycle(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 3; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][3]int{
+		{0, 1, 1},
+		{1, 2, -3},
+		{2, 0, 1},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1], EdgeWeight(e[2])); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	_, _, err := BellmanFordShortestPathTree(g, 0)
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Fatalf("BellmanFordShortestPathTree error = %v, want wrapped %v", err, ErrNegativeCycle)
+	}
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"fmt"
+)
+
+// KShortestPaths computes up to k loopless shortest paths between a source
+// and a target vertex, ordered from cheapest to most expensive, using Yen's
+// algorithm on top of ShortestPath.
+//
+// Unlike FindAllPaths, which enumerates every simple path and therefore blows
+// up on graphs with even modest branching, KShortestPaths only ever computes
+// one initial shortest-path search plus one spur search per vertex (except
+// the target) on each path it has already returned, giving loopless ranked
+// paths in polynomial time per returned path.
+//
+// KShortestPaths may return fewer than k paths if the graph doesn't have that
+// many loopless paths between source and target.
+func KShortestPaths[K comparable, T any](g Graph[K, T], source, target K, k int) ([][]K, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("k must be at least 1, got %d", k)
+	}
+
+	first, err := ShortestPath(g, source, target)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute the shortest path: %w", err)
+	}
+
+	a := [][]K{first}
+
+	candidateQueue := newPriorityQueue[int]()
+	candidatePaths := make(map[int][]K)
+	seen := make(map[string]bool)
+	seen[pathKey(first)] = true
+	nextCandidateID := 0
+
+	for i := 1; i < k; i++ {
+		previous := a[i-1]
+
+		for j := 0; j < len(previous)-1; j++ {
+			spurNode := previous[j]
+			rootPath := previous[:j+1]
+
+			spurGraph, err := g.Clone()
+			if err != nil {
+				return nil, fmt.Errorf("could not clone graph: %w", err)
+			}
+
+			for _, path := range a {
+				if len(path) > j && samePrefix(path[:j+1], rootPath) {
+					if _, err := spurGraph.Edge(path[j], path[j+1]); err != nil {
+						continue
+					}
+					if err := spurGraph.RemoveEdge(path[j], path[j+1]); err != nil {
+						return nil, fmt.Errorf("could not remove edge (%v, %v): %w", path[j], path[j+1], err)
+					}
+				}
+			}
+
+			for _, vertex := range rootPath[:len(rootPath)-1] {
+				if err := removeVertexAndEdges(spurGraph, vertex); err != nil {
+					return nil, fmt.Errorf("could not remove prefix vertex %v: %w", vertex, err)
+				}
+			}
+
+			spurPath, err := ShortestPath(spurGraph, spurNode, target)
+			if err != nil {
+				continue
+			}
+
+			totalPath := append(append([]K{}, rootPath[:len(rootPath)-1]...), spurPath...)
+			key := pathKey(totalPath)
+
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			weight, err := totalWeight(g, totalPath)
+			if err != nil {
+				continue
+			}
+
+			candidatePaths[nextCandidateID] = totalPath
+			candidateQueue.Push(nextCandidateID, weight)
+			nextCandidateID++
+		}
+
+		if candidateQueue.Len() == 0 {
+			break
+		}
+
+		id, _ := candidateQueue.Pop()
+		a = append(a, candidatePaths[id])
+		delete(candidatePaths, id)
+	}
+
+	return a, nil
+}
+
+// removeVertexAndEdges removes vertex from g. Graph implementations refuse to
+// remove a vertex that still has incident edges, which is true for virtually
+// every root-path vertex in Yen's algorithm, so its incident edges are
+// removed first.
+func removeVertexAndEdges[K comparable, T any](g Graph[K, T], vertex K) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for successor := range adjacencyMap[vertex] {
+		if err := g.RemoveEdge(vertex, successor); err != nil {
+			return fmt.Errorf("could not remove edge (%v, %v): %w", vertex, successor, err)
+		}
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	for predecessor := range predecessorMap[vertex] {
+		if err := g.RemoveEdge(predecessor, vertex); err != nil {
+			return fmt.Errorf("could not remove edge (%v, %v): %w", predecessor, vertex, err)
+		}
+	}
+
+	return g.RemoveVertex(vertex)
+}
+
+// samePrefix reports whether a and b are equal element-wise.
+func samePrefix[K comparable](a, b []K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathKey derives a comparable key identifying a path, used to avoid pushing
+// the same candidate onto the queue more than once.
+func pathKey[K comparable](path []K) string {
+	return fmt.Sprint(path)
+}
+
+// totalWeight sums the edge weights along path, falling back to a unit weight
+// per edge for unweighted graphs the same way ShortestPath does.
+func totalWeight[K comparable, T any](g Graph[K, T], path []K) (float64, error) {
+	weight := 0.0
+
+	for i := 0; i < len(path)-1; i++ {
+		edge, err := g.Edge(path[i], path[i+1])
+		if err != nil {
+			return 0, fmt.Errorf("could not get edge between %v and %v: %w", path[i], path[i+1], err)
+		}
+
+		if g.Traits().IsWeighted {
+			weight += float64(edge.Properties.Weight)
+		} else {
+			weight++
+		}
+	}
+
+	return weight, nil
+}
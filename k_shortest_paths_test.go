@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKShortestPathsAreLoopless(t *testing.T) {
+	g := New(StringHash, Directed())
+
+	for _, v := range []string{"S", "A", "B", "T", "D"} {
+		if err := g.AddVertex(v); err != nil {
+			t.Fatalf("failed to add vertex %s: %v", v, err)
+		}
+	}
+
+	edges := [][2]string{
+		{"S", "A"},
+		{"A", "B"},
+		{"B", "T"},
+		{"A", "S"},
+		{"S", "D"},
+		{"D", "T"},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %s->%s: %v", e[0], e[1], err)
+		}
+	}
+
+	paths, err := KShortestPaths(g, "S", "T", 3)
+	if err != nil {
+		t.Fatalf("KShortestPaths returned an error: %v", err)
+	}
+
+	for _, path := range paths {
+		seen := make(map[string]bool, len(path))
+		for _, vertex := range path {
+			if seen[vertex] {
+				t.Errorf("path %v is not loopless: vertex %s appears more than once", path, vertex)
+			}
+			seen[vertex] = true
+		}
+	}
+}
+
+func TestKShortestPathsOrderedByWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 4; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][3]int{
+		{0, 1, 1},
+		{1, 3, 1},
+		{0, 2, 1},
+		{2, 3, 2},
+	}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1], EdgeWeight(e[2])); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	paths, err := KShortestPaths(g, 0, 3, 2)
+	if err != nil {
+		t.Fatalf("KShortestPaths returned an error: %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %d: %v", len(paths), paths)
+	}
+
+	want := [][]int{{0, 1, 3}, {0, 2, 3}}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("KShortestPaths = %v, want %v", paths, want)
+	}
+}
@@ -69,6 +69,19 @@ func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, e
 //
 // ShortestPath has a time complexity of O(|V|+|E|log(|V|)).
 func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+	return dijkstra(g, source, target, nil, nil)
+}
+
+// dijkstra is the shared Dijkstra search backing ShortestPath,
+// ShortestPathFunc, and EdgeWeightFunc. allow, if non-nil, gates which edges
+// may be relaxed. weight, if non-nil, overrides how an edge's cost is
+// derived. Both default to the behavior ShortestPath has always had.
+func dijkstra[K comparable, T any](
+	g Graph[K, T],
+	source, target K,
+	allow func(from, to K, edge Edge[K]) bool,
+	weight func(from, to K, edge Edge[K]) float64,
+) ([]K, error) {
 	weights := make(map[K]float64)
 	visited := make(map[K]bool)
 
@@ -100,21 +113,31 @@ func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, er
 		hasInfiniteWeight := math.IsInf(weights[vertex], 1)
 
 		for adjacency, edge := range adjacencyMap[vertex] {
-			edgeWeight := edge.Properties.Weight
+			if allow != nil && !allow(vertex, adjacency, edge) {
+				continue
+			}
+
+			var edgeWeight float64
 
-			// Setting the weight to 1 is required for unweighted graphs whose
-			// edge weights are 0. Otherwise, all paths would have a sum of 0
-			// and a random path would be returned.
-			if !g.Traits().IsWeighted {
-				edgeWeight = 1
+			if weight != nil {
+				edgeWeight = weight(vertex, adjacency, edge)
+			} else {
+				edgeWeight = float64(edge.Properties.Weight)
+
+				// Setting the weight to 1 is required for unweighted graphs
+				// whose edge weights are 0. Otherwise, all paths would have a
+				// sum of 0 and a random path would be returned.
+				if !g.Traits().IsWeighted {
+					edgeWeight = 1
+				}
 			}
 
-			weight := weights[vertex] + float64(edgeWeight)
+			pathWeight := weights[vertex] + edgeWeight
 
-			if weight < weights[adjacency] && !hasInfiniteWeight {
-				weights[adjacency] = weight
+			if pathWeight < weights[adjacency] && !hasInfiniteWeight {
+				weights[adjacency] = pathWeight
 				bestPredecessors[adjacency] = vertex
-				queue.UpdatePriority(adjacency, weight)
+				queue.UpdatePriority(adjacency, pathWeight)
 			}
 		}
 	}
@@ -182,55 +205,106 @@ func StronglyConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, err
 	return state.components, nil
 }
 
-func findSCC[K comparable](vertexHash K, state *sccState[K]) {
-	state.stack = append(state.stack, vertexHash)
-	state.onStack[vertexHash] = true
-	state.visited[vertexHash] = struct{}{}
-	state.index[vertexHash] = state.time
-	state.lowlink[vertexHash] = state.time
+// sccFrame is one level of the DFS findSCC would otherwise recurse into. It
+// pairs a vertex with an iterator over its adjacency so the traversal can be
+// suspended and resumed without growing the Go call stack, which would
+// otherwise overflow on deep, chain-like graphs such as build-dependency or
+// blockchain graphs.
+type sccFrame[K comparable] struct {
+	vertex    K
+	neighbors []K
+	next      int
+}
+
+func newSCCFrame[K comparable](vertexHash K, state *sccState[K]) *sccFrame[K] {
+	neighbors := make([]K, 0, len(state.adjacencyMap[vertexHash]))
+	for adjacency := range state.adjacencyMap[vertexHash] {
+		neighbors = append(neighbors, adjacency)
+	}
+
+	return &sccFrame[K]{vertex: vertexHash, neighbors: neighbors}
+}
+
+func findSCC[K comparable](startHash K, state *sccState[K]) {
+	frames := []*sccFrame[K]{newSCCFrame(startHash, state)}
 
+	state.stack = append(state.stack, startHash)
+	state.onStack[startHash] = true
+	state.visited[startHash] = struct{}{}
+	state.index[startHash] = state.time
+	state.lowlink[startHash] = state.time
 	state.time++
 
-	for adjacency := range state.adjacencyMap[vertexHash] {
-		if _, ok := state.visited[adjacency]; !ok {
-			findSCC(adjacency, state)
+	for len(frames) > 0 {
+		frame := frames[len(frames)-1]
+		descended := false
+
+		for frame.next < len(frame.neighbors) {
+			adjacency := frame.neighbors[frame.next]
+			frame.next++
+
+			if _, ok := state.visited[adjacency]; !ok {
+				state.stack = append(state.stack, adjacency)
+				state.onStack[adjacency] = true
+				state.visited[adjacency] = struct{}{}
+				state.index[adjacency] = state.time
+				state.lowlink[adjacency] = state.time
+				state.time++
+
+				frames = append(frames, newSCCFrame(adjacency, state))
+				descended = true
+				break
+			}
 
-			smallestLowlink := math.Min(
-				float64(state.lowlink[vertexHash]),
-				float64(state.lowlink[adjacency]),
-			)
-			state.lowlink[vertexHash] = int(smallestLowlink)
-		} else {
 			// If the adjacent vertex already is on the stack, the edge joining
 			// the current and the adjacent vertex is a back ege. Therefore, the
 			// lowlink value of the vertex has to be updated to the index of the
 			// adjacent vertex if it is smaller than the current lowlink value.
 			if state.onStack[adjacency] {
 				smallestLowlink := math.Min(
-					float64(state.lowlink[vertexHash]),
+					float64(state.lowlink[frame.vertex]),
 					float64(state.index[adjacency]),
 				)
-				state.lowlink[vertexHash] = int(smallestLowlink)
+				state.lowlink[frame.vertex] = int(smallestLowlink)
 			}
 		}
-	}
 
-	// If the lowlink value of the vertex is equal to its DFS value, this is the
-	// head vertex of a strongly connected component that's shaped by the vertex
-	// and all vertices on the stack.
-	if state.lowlink[vertexHash] == state.index[vertexHash] {
-		var hash K
-		var component []K
+		if descended {
+			continue
+		}
 
-		for hash != vertexHash {
-			hash = state.stack[len(state.stack)-1]
-			state.stack = state.stack[:len(state.stack)-1]
-			state.onStack[hash] = false
+		// The frame's adjacency iterator is exhausted: this is the equivalent
+		// of a findSCC call returning in the recursive version. Propagate its
+		// lowlink into the parent frame, the same way the recursive version
+		// updates vertexHash's lowlink right after the recursive call.
+		frames = frames[:len(frames)-1]
 
-			component = append(component, hash)
+		if len(frames) > 0 {
+			parent := frames[len(frames)-1]
+			smallestLowlink := math.Min(
+				float64(state.lowlink[parent.vertex]),
+				float64(state.lowlink[frame.vertex]),
+			)
+			state.lowlink[parent.vertex] = int(smallestLowlink)
 		}
 
-		state.components = append(state.components, component)
+		// If the lowlink value of the vertex is equal to its DFS value, this is
+		// the head vertex of a strongly connected component that's shaped by the
+		// vertex and all vertices on the stack.
+		if state.lowlink[frame.vertex] == state.index[frame.vertex] {
+			var hash K
+			var component []K
+
+			for hash != frame.vertex {
+				hash = state.stack[len(state.stack)-1]
+				state.stack = state.stack[:len(state.stack)-1]
+				state.onStack[hash] = false
+
+				component = append(component, hash)
+			}
+
+			state.components = append(state.components, component)
+		}
 	}
 }
 
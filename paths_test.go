@@ -0,0 +1,34 @@
+package graph
+
+import "testing"
+
+// TestStronglyConnectedComponentsDeepPathGraph is a regression test for the
+// iterative rewrite of findSCC. A 1,000,000-vertex path graph drives a DFS
+// depth of 1,000,000, which reliably overflowed the goroutine stack with the
+// previous recursive implementation.
+func TestStronglyConnectedComponentsDeepPathGraph(t *testing.T) {
+	const order = 1_000_000
+
+	g := New(IntHash, Directed())
+
+	for i := 0; i < order; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	for i := 0; i < order-1; i++ {
+		if err := g.AddEdge(i, i+1); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", i, i+1, err)
+		}
+	}
+
+	components, err := StronglyConnectedComponents(g)
+	if err != nil {
+		t.Fatalf("StronglyConnectedComponents returned an error: %v", err)
+	}
+
+	if len(components) != order {
+		t.Errorf("expected %d single-vertex components in an acyclic path graph, got %d", order, len(components))
+	}
+}
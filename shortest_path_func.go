@@ -0,0 +1,31 @@
+package graph
+
+// ShortestPathFunc computes the shortest path between a source and a target
+// vertex the same way ShortestPath does, except an edge is only ever relaxed
+// if allow returns true for it. This lets callers express traversal
+// constraints such as "cannot descend to a lower-tier node" or toll-road
+// avoidance without copying the whole Dijkstra search.
+//
+// allow is called with the edge's source and target vertex hashes and the
+// edge itself. ShortestPath, ShortestPathFunc, and EdgeWeightFunc all share
+// the same underlying Dijkstra search, with ShortestPath passing no allow
+// function, so that every edge may be relaxed.
+func ShortestPathFunc[K comparable, T any](g Graph[K, T], source, target K, allow func(from, to K, edge Edge[K]) bool) ([]K, error) {
+	return dijkstra(g, source, target, allow, nil)
+}
+
+// EdgeWeightFunc computes the shortest path between a source and a target
+// vertex the same way ShortestPath does, except the weight of an edge is
+// derived by calling weight instead of reading edge.Properties.Weight. This
+// lets callers express weights that depend on vertex values, time-of-day, or
+// capacity, without having to store them as static edge weights.
+//
+// weight is called with the edge's source and target vertex hashes and the
+// edge itself, and must return a nonnegative weight. ShortestPath, along with
+// ShortestPathFunc and EdgeWeightFunc, shares the same underlying Dijkstra
+// search, with ShortestPath passing no weight function, so that the search
+// reads edge.Properties.Weight, falling back to a unit weight for unweighted
+// graphs.
+func EdgeWeightFunc[K comparable, T any](g Graph[K, T], source, target K, weight func(from, to K, edge Edge[K]) float64) ([]K, error) {
+	return dijkstra(g, source, target, nil, weight)
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShortestPathFuncForbidsDescendingEdges(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	tier := map[int]int{0: 2, 1: 1, 2: 1, 3: 1}
+
+	for v := range tier {
+		if err := g.AddVertex(v); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", v, err)
+		}
+	}
+
+	edges := [][2]int{{0, 2}, {0, 1}, {1, 3}, {3, 2}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	allow := func(from, to int, edge Edge[int]) bool {
+		return tier[to] >= tier[from]
+	}
+
+	if _, err := ShortestPathFunc(g, 0, 2, allow); err != ErrTargetNotReachable {
+		t.Fatalf("ShortestPathFunc error = %v, want %v (descending edge 0->2 must be forbidden)", err, ErrTargetNotReachable)
+	}
+
+	got, err := ShortestPathFunc(g, 1, 2, allow)
+	if err != nil {
+		t.Fatalf("ShortestPathFunc returned an error: %v", err)
+	}
+
+	want := []int{1, 3, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShortestPathFunc = %v, want %v", got, want)
+	}
+}
+
+func TestEdgeWeightFuncOverridesStaticWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for i := 0; i < 3; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	if err := g.AddEdge(0, 1, EdgeWeight(5)); err != nil {
+		t.Fatalf("failed to add edge 0->1: %v", err)
+	}
+	if err := g.AddEdge(0, 2, EdgeWeight(1)); err != nil {
+		t.Fatalf("failed to add edge 0->2: %v", err)
+	}
+	if err := g.AddEdge(2, 1, EdgeWeight(1)); err != nil {
+		t.Fatalf("failed to add edge 2->1: %v", err)
+	}
+
+	// weight makes every edge landing on vertex 1 cost 10 regardless of its
+	// static weight, so the direct edge 0->1 (cost 10) still beats the detour
+	// 0->2->1 (cost 1+10=11). The static weights alone would pick the detour
+	// (cost 1+1=2) over the direct edge (cost 5).
+	weight := func(from, to int, edge Edge[int]) float64 {
+		if to == 1 {
+			return 10
+		}
+		return float64(edge.Properties.Weight)
+	}
+
+	got, err := EdgeWeightFunc(g, 0, 1, weight)
+	if err != nil {
+		t.Fatalf("EdgeWeightFunc returned an error: %v", err)
+	}
+
+	want := []int{0, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EdgeWeightFunc = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrCyclicGraph is returned by TopologicalSort and StableTopologicalSort when
+// the graph contains a cycle, which makes a topological ordering impossible.
+// It wraps the vertices that are still left with a nonzero in-degree once
+// Kahn's algorithm stalls, i.e. the vertices forming (or reachable only
+// through) a cycle.
+var ErrCyclicGraph = errors.New("topological sort cannot be computed on graph with cycle(s)")
+
+// TopologicalSort computes a topological ordering of the given graph using
+// Kahn's algorithm: starting from every vertex with an in-degree of 0, it
+// repeatedly visits a vertex, appends it to the result, and decrements the
+// in-degree of its successors, enqueueing any successor whose in-degree drops
+// to 0.
+//
+// TopologicalSort doesn't guarantee a deterministic result for graphs that
+// admit more than one valid ordering, because ties between same-in-degree
+// vertices are broken by Go's arbitrary map iteration order. Use
+// StableTopologicalSort if a reproducible ordering is required.
+//
+// If the graph contains a cycle, TopologicalSort returns ErrCyclicGraph.
+func TopologicalSort[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	return topologicalSort(g, nil)
+}
+
+// StableTopologicalSort computes a topological ordering the same way
+// TopologicalSort does, except ties between vertices that are simultaneously
+// ready to be visited are always broken using less, making the result
+// reproducible across runs. This is needed by callers that rely on a
+// topological sort for task or build-DAG scheduling.
+//
+// If the graph contains a cycle, StableTopologicalSort returns
+// ErrCyclicGraph.
+func StableTopologicalSort[K comparable, T any](g Graph[K, T], less func(a, b K) bool) ([]K, error) {
+	if less == nil {
+		return nil, errors.New("less function must not be nil")
+	}
+	return topologicalSort(g, less)
+}
+
+func topologicalSort[K comparable, T any](g Graph[K, T], less func(a, b K) bool) ([]K, error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("topological sort cannot be computed on an undirected graph")
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(predecessorMap))
+	queue := make([]K, 0)
+
+	for vertex, predecessors := range predecessorMap {
+		inDegrees[vertex] = len(predecessors)
+
+		if len(predecessors) == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	sortQueue := func() {
+		if less != nil {
+			sort.Slice(queue, func(i, j int) bool { return less(queue[i], queue[j]) })
+		}
+	}
+
+	sortQueue()
+
+	order := make([]K, 0, len(inDegrees))
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		order = append(order, vertex)
+
+		successors := make([]K, 0, len(adjacencyMap[vertex]))
+		for successor := range adjacencyMap[vertex] {
+			successors = append(successors, successor)
+		}
+		if less != nil {
+			sort.Slice(successors, func(i, j int) bool { return less(successors[i], successors[j]) })
+		}
+
+		for _, successor := range successors {
+			inDegrees[successor]--
+			if inDegrees[successor] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+
+		sortQueue()
+	}
+
+	if len(order) < len(inDegrees) {
+		remaining := make([]K, 0)
+		for vertex, degree := range inDegrees {
+			if degree > 0 {
+				remaining = append(remaining, vertex)
+			}
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCyclicGraph, remaining)
+	}
+
+	return order, nil
+}
@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopologicalSortOrdersDAG(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 4; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	order, err := TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("TopologicalSort returned an error: %v", err)
+	}
+
+	position := make(map[int]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	for _, e := range edges {
+		if position[e[0]] >= position[e[1]] {
+			t.Errorf("vertex %d should come before %d in %v", e[0], e[1], order)
+		}
+	}
+}
+
+func TestTopologicalSortDetectsCycle(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 3; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 0}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	if _, err := TopologicalSort(g); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("TopologicalSort error = %v, want wrapped %v", err, ErrCyclicGraph)
+	}
+}
+
+func TestTopologicalSortRejectsUndirectedGraph(t *testing.T) {
+	g := New(IntHash)
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("failed to add edge 0->1: %v", err)
+	}
+
+	if _, err := TopologicalSort(g); err == nil {
+		t.Error("TopologicalSort on an undirected graph should return an error, got nil")
+	}
+}
+
+func TestStableTopologicalSortIsDeterministic(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 4; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][2]int{{0, 3}, {1, 3}, {2, 3}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	less := func(a, b int) bool { return a < b }
+
+	order, err := StableTopologicalSort(g, less)
+	if err != nil {
+		t.Fatalf("StableTopologicalSort returned an error: %v", err)
+	}
+
+	want := []int{0, 1, 2, 3}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("StableTopologicalSort = %v, want %v", order, want)
+	}
+}
+
+func TestStableTopologicalSortRequiresLess(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	if _, err := StableTopologicalSort[int, int](g, nil); err == nil {
+		t.Error("StableTopologicalSort with a nil less function should return an error, got nil")
+	}
+}
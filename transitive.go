@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TransitiveReduction returns a new graph with the same vertices and the
+// fewest possible edges such that the reachability relation of the original
+// graph is preserved: an edge (u, v) is removed whenever there also exists
+// another directed path from u to v of length 2 or more.
+//
+// TransitiveReduction only works on directed, acyclic graphs. Running it on a
+// graph that has a strongly connected component of more than one vertex
+// returns an error, since the classical reduction algorithm is only defined
+// for DAGs.
+func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("transitive reduction cannot be performed on an undirected graph")
+	}
+
+	sccs, err := StronglyConnectedComponents(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not detect strongly connected components: %w", err)
+	}
+
+	for _, component := range sccs {
+		if len(component) > 1 {
+			return nil, fmt.Errorf("transitive reduction requires an acyclic graph, but found a cycle among %v", component)
+		}
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	reduced, err := g.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("could not clone graph: %w", err)
+	}
+
+	for vertex, successors := range adjacencyMap {
+		for successor := range successors {
+			if hasIndirectPath(adjacencyMap, vertex, successor) {
+				if err := reduced.RemoveEdge(vertex, successor); err != nil {
+					return nil, fmt.Errorf("could not remove edge (%v, %v): %w", vertex, successor, err)
+				}
+			}
+		}
+	}
+
+	return reduced, nil
+}
+
+// hasIndirectPath reports whether target is reachable from source through any
+// successor of source other than target itself, i.e. through a path of length
+// 2 or more that doesn't rely on the direct edge (source, target).
+func hasIndirectPath[K comparable](adjacencyMap map[K]map[K]Edge[K], source, target K) bool {
+	visited := make(map[K]bool)
+	stack := make([]K, 0)
+
+	for successor := range adjacencyMap[source] {
+		if successor != target {
+			stack = append(stack, successor)
+		}
+	}
+
+	for len(stack) > 0 {
+		vertex := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if vertex == target {
+			return true
+		}
+
+		if visited[vertex] {
+			continue
+		}
+		visited[vertex] = true
+
+		for next := range adjacencyMap[vertex] {
+			stack = append(stack, next)
+		}
+	}
+
+	return false
+}
+
+// TransitiveClosure returns a new graph with the same vertices as g and an
+// edge (u, v) for every pair of distinct vertices where v is reachable from u,
+// in addition to the edges g already has. No self-loop is added for a vertex
+// that can reach itself through a cycle.
+func TransitiveClosure[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	closure, err := g.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("could not clone graph: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		visited := make(map[K]bool)
+		stack := make([]K, 0)
+
+		for successor := range adjacencyMap[vertex] {
+			stack = append(stack, successor)
+		}
+
+		for len(stack) > 0 {
+			reachable := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if visited[reachable] {
+				continue
+			}
+			visited[reachable] = true
+
+			if reachable != vertex {
+				if _, err := closure.Edge(vertex, reachable); err != nil {
+					if err := closure.AddEdge(vertex, reachable); err != nil {
+						return nil, fmt.Errorf("could not add edge (%v, %v): %w", vertex, reachable, err)
+					}
+				}
+			}
+
+			for next := range adjacencyMap[reachable] {
+				stack = append(stack, next)
+			}
+		}
+	}
+
+	return closure, nil
+}
@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+)
+
+func adjacencyLists[K comparable, T any](t *testing.T, g Graph[K, T]) map[K][]K {
+	t.Helper()
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		t.Fatalf("could not get adjacency map: %v", err)
+	}
+
+	result := make(map[K][]K, len(adjacencyMap))
+	for vertex, successors := range adjacencyMap {
+		list := make([]K, 0, len(successors))
+		for successor := range successors {
+			list = append(list, successor)
+		}
+		result[vertex] = list
+	}
+
+	return result
+}
+
+func TestTransitiveReductionDropsRedundantEdges(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 3; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	// 0 -> 1 -> 2 and a redundant direct edge 0 -> 2.
+	edges := [][2]int{{0, 1}, {1, 2}, {0, 2}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	reduced, err := TransitiveReduction(g)
+	if err != nil {
+		t.Fatalf("TransitiveReduction returned an error: %v", err)
+	}
+
+	if _, err := reduced.Edge(0, 2); err == nil {
+		t.Error("TransitiveReduction should have removed the redundant edge (0, 2)")
+	}
+
+	if _, err := reduced.Edge(0, 1); err != nil {
+		t.Errorf("TransitiveReduction should have kept edge (0, 1): %v", err)
+	}
+	if _, err := reduced.Edge(1, 2); err != nil {
+		t.Errorf("TransitiveReduction should have kept edge (1, 2): %v", err)
+	}
+}
+
+func TestTransitiveReductionRejectsUndirectedGraph(t *testing.T) {
+	g := New(IntHash)
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("failed to add edge 0->1: %v", err)
+	}
+
+	if _, err := TransitiveReduction(g); err == nil {
+		t.Error("TransitiveReduction on an undirected graph should return an error, got nil")
+	}
+}
+
+func TestTransitiveReductionRejectsCycles(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 2; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("failed to add edge 0->1: %v", err)
+	}
+	if err := g.AddEdge(1, 0); err != nil {
+		t.Fatalf("failed to add edge 1->0: %v", err)
+	}
+
+	if _, err := TransitiveReduction(g); err == nil {
+		t.Error("TransitiveReduction on a cyclic graph should return an error, got nil")
+	}
+}
+
+func TestTransitiveClosureAddsReachabilityEdges(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 3; i++ {
+		if err := g.AddVertex(i); err != nil {
+			t.Fatalf("failed to add vertex %d: %v", i, err)
+		}
+	}
+
+	edges := [][2]int{{0, 1}, {1, 2}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("failed to add edge %d->%d: %v", e[0], e[1], err)
+		}
+	}
+
+	closure, err := TransitiveClosure(g)
+	if err != nil {
+		t.Fatalf("TransitiveClosure returned an error: %v", err)
+	}
+
+	if _, err := closure.Edge(0, 2); err != nil {
+		t.Errorf("TransitiveClosure should have added edge (0, 2): %v", err)
+	}
+	if _, err := closure.Edge(0, 1); err != nil {
+		t.Errorf("TransitiveClosure should have kept edge (0, 1): %v", err)
+	}
+	if _, err := closure.Edge(2, 0); err == nil {
+		t.Error("TransitiveClosure should not add an edge where there's no path (2, 0)")
+	}
+
+	adjacency := adjacencyLists(t, closure)
+	sort.Ints(adjacency[0])
+	want := []int{1, 2}
+	if len(adjacency[0]) != len(want) || adjacency[0][0] != want[0] || adjacency[0][1] != want[1] {
+		t.Errorf("closure successors of 0 = %v, want %v", adjacency[0], want)
+	}
+}